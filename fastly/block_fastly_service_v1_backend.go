@@ -0,0 +1,198 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var backendSchema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name for this Backend",
+			},
+
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "An IPv4, hostname, or IPv6 address for the Backend",
+			},
+
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     80,
+				Description: "The port number on which the Backend responds",
+			},
+
+			"use_ssl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether or not to use SSL to reach the Backend",
+			},
+
+			"ssl_check_cert": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Be strict on checking SSL certs",
+			},
+
+			"max_conn": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     200,
+				Description: "Maximum number of connections for this Backend",
+			},
+
+			"connect_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "How long to wait for a timeout in milliseconds",
+			},
+
+			"first_byte_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     15000,
+				Description: "How long to wait for the first byte in milliseconds",
+			},
+
+			"weight": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "The portion of traffic to send to this Backend, expressed as a percentage",
+			},
+
+			"shield": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The POP of the shield designated to reduce the load on the Backend",
+			},
+
+			"request_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a condition, which if met, will select this backend during a request",
+			},
+		},
+	},
+}
+
+func processBackend(d *schema.ResourceData, conn *gofastly.Client, latestVersion int) error {
+	serviceID := d.Id()
+	ob, nb := d.GetChange("backend")
+
+	if ob == nil {
+		ob = new(schema.Set)
+	}
+	if nb == nil {
+		nb = new(schema.Set)
+	}
+
+	obs := ob.(*schema.Set)
+	nbs := nb.(*schema.Set)
+
+	removeBackends := obs.Difference(nbs).List()
+	addBackends := nbs.Difference(obs).List()
+
+	for _, bRaw := range removeBackends {
+		bf := bRaw.(map[string]interface{})
+		log.Printf("[DEBUG] Fastly Backend removal opts: %#v", bf)
+
+		err := conn.DeleteBackend(&gofastly.DeleteBackendInput{
+			Service: serviceID,
+			Version: latestVersion,
+			Name:    bf["name"].(string),
+		})
+
+		if errRes, ok := err.(*gofastly.HTTPError); ok {
+			if errRes.StatusCode != 404 {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+	}
+
+	for _, bRaw := range addBackends {
+		bf := bRaw.(map[string]interface{})
+		opts := buildCreateBackend(bf, serviceID, latestVersion)
+
+		log.Printf("[DEBUG] Fastly Backend addition opts: %#v", opts)
+
+		if _, err := conn.CreateBackend(opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readBackend(conn *gofastly.Client, d *schema.ResourceData, s *gofastly.ServiceDetail) error {
+	log.Printf("[DEBUG] Refreshing Backends for (%s)", d.Id())
+	backendList, err := conn.ListBackends(&gofastly.ListBackendsInput{
+		Service: d.Id(),
+		Version: s.ActiveVersion.Number,
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Backends for (%s), version (%v): %s", d.Id(), s.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("backend", flattenBackends(backendList)); err != nil {
+		log.Printf("[WARN] Error setting Backends for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func buildCreateBackend(backendMap interface{}, serviceID string, serviceVersion int) *gofastly.CreateBackendInput {
+	bf := backendMap.(map[string]interface{})
+	return &gofastly.CreateBackendInput{
+		Service:          serviceID,
+		Version:          serviceVersion,
+		Name:             bf["name"].(string),
+		Address:          bf["address"].(string),
+		Port:             uint(bf["port"].(int)),
+		UseSSL:           gofastly.CBool(bf["use_ssl"].(bool)),
+		SSLCheckCert:     gofastly.CBool(bf["ssl_check_cert"].(bool)),
+		MaxConn:          uint(bf["max_conn"].(int)),
+		ConnectTimeout:   uint(bf["connect_timeout"].(int)),
+		FirstByteTimeout: uint(bf["first_byte_timeout"].(int)),
+		Weight:           uint(bf["weight"].(int)),
+		Shield:           bf["shield"].(string),
+		RequestCondition: bf["request_condition"].(string),
+	}
+}
+
+func flattenBackends(backendList []*gofastly.Backend) []map[string]interface{} {
+	var bl []map[string]interface{}
+	for _, b := range backendList {
+		bl = append(bl, map[string]interface{}{
+			"name":               b.Name,
+			"address":            b.Address,
+			"port":               b.Port,
+			"use_ssl":            b.UseSSL,
+			"ssl_check_cert":     b.SSLCheckCert,
+			"max_conn":           b.MaxConn,
+			"connect_timeout":    b.ConnectTimeout,
+			"first_byte_timeout": b.FirstByteTimeout,
+			"weight":             b.Weight,
+			"shield":             b.Shield,
+			"request_condition":  b.RequestCondition,
+		})
+	}
+
+	return bl
+}
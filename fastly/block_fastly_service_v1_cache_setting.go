@@ -0,0 +1,142 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var cacheSettingSchema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A unique name to identify this cache setting",
+			},
+
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "One of `cache`, `pass`, or `restart`, indicating how the cache should respond to matching requests",
+				ValidateFunc: validation.StringInSlice([]string{"cache", "pass", "restart"}, false),
+			},
+
+			"cache_condition": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the condition controlling when this configuration applies",
+			},
+
+			"stale_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Max time in seconds for which a stale object should be delivered",
+			},
+
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Max time in seconds to cache an object",
+			},
+		},
+	},
+}
+
+func processCacheSetting(d *schema.ResourceData, conn *gofastly.Client, latestVersion int) error {
+	serviceID := d.Id()
+	oc, nc := d.GetChange("cache_setting")
+
+	if oc == nil {
+		oc = new(schema.Set)
+	}
+	if nc == nil {
+		nc = new(schema.Set)
+	}
+
+	ocs := oc.(*schema.Set)
+	ncs := nc.(*schema.Set)
+
+	removeCacheSettings := ocs.Difference(ncs).List()
+	addCacheSettings := ncs.Difference(ocs).List()
+
+	for _, cRaw := range removeCacheSettings {
+		cf := cRaw.(map[string]interface{})
+		log.Printf("[DEBUG] Fastly Cache Settings removal opts: %#v", cf)
+
+		err := conn.DeleteCacheSetting(&gofastly.DeleteCacheSettingInput{
+			Service: serviceID,
+			Version: latestVersion,
+			Name:    cf["name"].(string),
+		})
+
+		if errRes, ok := err.(*gofastly.HTTPError); ok {
+			if errRes.StatusCode != 404 {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+	}
+
+	for _, cRaw := range addCacheSettings {
+		cf := cRaw.(map[string]interface{})
+		opts := gofastly.CreateCacheSettingInput{
+			Service:        serviceID,
+			Version:        latestVersion,
+			Name:           cf["name"].(string),
+			Action:         gofastly.CacheSettingAction(cf["action"].(string)),
+			CacheCondition: cf["cache_condition"].(string),
+			StaleTTL:       uint(cf["stale_ttl"].(int)),
+			TTL:            uint(cf["ttl"].(int)),
+		}
+
+		log.Printf("[DEBUG] Fastly Cache Settings addition opts: %#v", opts)
+
+		if _, err := conn.CreateCacheSetting(&opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readCacheSetting(conn *gofastly.Client, d *schema.ResourceData, s *gofastly.ServiceDetail) error {
+	log.Printf("[DEBUG] Refreshing Cache Settings for (%s)", d.Id())
+	cslList, err := conn.ListCacheSettings(&gofastly.ListCacheSettingsInput{
+		Service: d.Id(),
+		Version: s.ActiveVersion.Number,
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Cache Settings for (%s), version (%v): %s", d.Id(), s.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("cache_setting", flattenCacheSettings(cslList)); err != nil {
+		log.Printf("[WARN] Error setting Cache Settings for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenCacheSettings(cslList []*gofastly.CacheSetting) []map[string]interface{} {
+	var csl []map[string]interface{}
+	for _, cs := range cslList {
+		csl = append(csl, map[string]interface{}{
+			"name":            cs.Name,
+			"action":          cs.Action,
+			"cache_condition": cs.CacheCondition,
+			"stale_ttl":       cs.StaleTTL,
+			"ttl":             cs.TTL,
+		})
+	}
+
+	return csl
+}
@@ -0,0 +1,159 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var conditionSchema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique name for the condition",
+			},
+
+			"statement": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The statement used to determine if the condition is met",
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Type of the condition, either `REQUEST`, `RESPONSE`, or `CACHE`",
+				ValidateFunc: validation.StringInSlice([]string{"REQUEST", "RESPONSE", "CACHE"}, false),
+			},
+
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: "A number used to determine the order in which multiple conditions execute. Lower numbers execute first",
+			},
+		},
+	},
+}
+
+// processConditionCreate and processConditionDelete are split, rather than
+// a single processCondition, because backend/header/gzip/
+// cache_setting/httpslogging can reference a condition by name via
+// request_condition/cache_condition/response_condition. The caller must
+// create new conditions before touching those blocks (so a brand new
+// condition already exists for anything that references it) and delete
+// removed conditions only after, so a condition that's still referenced by
+// a not-yet-updated block isn't deleted out from under it.
+
+func processConditionCreate(d *schema.ResourceData, conn *gofastly.Client, latestVersion int) error {
+	serviceID := d.Id()
+	oc, nc := d.GetChange("condition")
+
+	if oc == nil {
+		oc = new(schema.Set)
+	}
+	if nc == nil {
+		nc = new(schema.Set)
+	}
+
+	ocs := oc.(*schema.Set)
+	ncs := nc.(*schema.Set)
+
+	addConditions := ncs.Difference(ocs).List()
+
+	for _, cRaw := range addConditions {
+		cf := cRaw.(map[string]interface{})
+		log.Printf("[DEBUG] Fastly Condition addition opts: %#v", cf)
+
+		_, err := conn.CreateCondition(&gofastly.CreateConditionInput{
+			Service:   serviceID,
+			Version:   latestVersion,
+			Name:      cf["name"].(string),
+			Statement: cf["statement"].(string),
+			Type:      cf["type"].(string),
+			Priority:  cf["priority"].(int),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func processConditionDelete(d *schema.ResourceData, conn *gofastly.Client, latestVersion int) error {
+	serviceID := d.Id()
+	oc, nc := d.GetChange("condition")
+
+	if oc == nil {
+		oc = new(schema.Set)
+	}
+	if nc == nil {
+		nc = new(schema.Set)
+	}
+
+	ocs := oc.(*schema.Set)
+	ncs := nc.(*schema.Set)
+
+	removeConditions := ocs.Difference(ncs).List()
+
+	for _, cRaw := range removeConditions {
+		cf := cRaw.(map[string]interface{})
+		log.Printf("[DEBUG] Fastly Condition removal opts: %#v", cf)
+
+		err := conn.DeleteCondition(&gofastly.DeleteConditionInput{
+			Service: serviceID,
+			Version: latestVersion,
+			Name:    cf["name"].(string),
+		})
+
+		if errRes, ok := err.(*gofastly.HTTPError); ok {
+			if errRes.StatusCode != 404 {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readCondition(conn *gofastly.Client, d *schema.ResourceData, s *gofastly.ServiceDetail) error {
+	log.Printf("[DEBUG] Refreshing Conditions for (%s)", d.Id())
+	conditionList, err := conn.ListConditions(&gofastly.ListConditionsInput{
+		Service: d.Id(),
+		Version: s.ActiveVersion.Number,
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Conditions for (%s), version (%v): %s", d.Id(), s.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("condition", flattenConditions(conditionList)); err != nil {
+		log.Printf("[WARN] Error setting Conditions for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenConditions(conditionList []*gofastly.Condition) []map[string]interface{} {
+	var cl []map[string]interface{}
+	for _, c := range conditionList {
+		cl = append(cl, map[string]interface{}{
+			"name":      c.Name,
+			"statement": c.Statement,
+			"type":      c.Type,
+			"priority":  c.Priority,
+		})
+	}
+
+	return cl
+}
@@ -0,0 +1,113 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var domainSchema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Required: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain that this service will respond to",
+			},
+
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Freeform descriptive note",
+			},
+		},
+	},
+}
+
+func processDomain(d *schema.ResourceData, conn *gofastly.Client, latestVersion int) error {
+	serviceID := d.Id()
+	od, nd := d.GetChange("domain")
+
+	if od == nil {
+		od = new(schema.Set)
+	}
+	if nd == nil {
+		nd = new(schema.Set)
+	}
+
+	ods := od.(*schema.Set)
+	nds := nd.(*schema.Set)
+
+	removeDomains := ods.Difference(nds).List()
+	addDomains := nds.Difference(ods).List()
+
+	for _, dRaw := range removeDomains {
+		df := dRaw.(map[string]interface{})
+		log.Printf("[DEBUG] Fastly Domain removal opts: %#v", df)
+
+		err := conn.DeleteDomain(&gofastly.DeleteDomainInput{
+			Service: serviceID,
+			Version: latestVersion,
+			Name:    df["name"].(string),
+		})
+
+		if errRes, ok := err.(*gofastly.HTTPError); ok {
+			if errRes.StatusCode != 404 {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+	}
+
+	for _, dRaw := range addDomains {
+		df := dRaw.(map[string]interface{})
+		log.Printf("[DEBUG] Fastly Domain addition opts: %#v", df)
+
+		_, err := conn.CreateDomain(&gofastly.CreateDomainInput{
+			Service: serviceID,
+			Version: latestVersion,
+			Name:    df["name"].(string),
+			Comment: df["comment"].(string),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readDomain(conn *gofastly.Client, d *schema.ResourceData, s *gofastly.ServiceDetail) error {
+	log.Printf("[DEBUG] Refreshing Domains for (%s)", d.Id())
+	domainList, err := conn.ListDomains(&gofastly.ListDomainsInput{
+		Service: d.Id(),
+		Version: s.ActiveVersion.Number,
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Domains for (%s), version (%v): %s", d.Id(), s.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("domain", flattenDomains(domainList)); err != nil {
+		log.Printf("[WARN] Error setting Domains for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenDomains(domainList []*gofastly.Domain) []map[string]interface{} {
+	var dl []map[string]interface{}
+	for _, d := range domainList {
+		dl = append(dl, map[string]interface{}{
+			"name":    d.Name,
+			"comment": d.Comment,
+		})
+	}
+
+	return dl
+}
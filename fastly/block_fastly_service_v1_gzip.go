@@ -0,0 +1,151 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var gzipSchema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A unique name to identify this gzip condition",
+			},
+
+			"content_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The content-type for each type of content you wish to have dynamically gzip'ed",
+			},
+
+			"extensions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "File extensions for each type of content you wish to have dynamically gzip'ed",
+			},
+
+			"cache_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a cache condition controlling when this gzip configuration applies",
+			},
+		},
+	},
+}
+
+func processGzip(d *schema.ResourceData, conn *gofastly.Client, latestVersion int) error {
+	serviceID := d.Id()
+	og, ng := d.GetChange("gzip")
+
+	if og == nil {
+		og = new(schema.Set)
+	}
+	if ng == nil {
+		ng = new(schema.Set)
+	}
+
+	ogs := og.(*schema.Set)
+	ngs := ng.(*schema.Set)
+
+	removeGzips := ogs.Difference(ngs).List()
+	addGzips := ngs.Difference(ogs).List()
+
+	for _, gRaw := range removeGzips {
+		gf := gRaw.(map[string]interface{})
+		log.Printf("[DEBUG] Fastly Gzip removal opts: %#v", gf)
+
+		err := conn.DeleteGzip(&gofastly.DeleteGzipInput{
+			Service: serviceID,
+			Version: latestVersion,
+			Name:    gf["name"].(string),
+		})
+
+		if errRes, ok := err.(*gofastly.HTTPError); ok {
+			if errRes.StatusCode != 404 {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+	}
+
+	for _, gRaw := range addGzips {
+		gf := gRaw.(map[string]interface{})
+		opts := gofastly.CreateGzipInput{
+			Service:        serviceID,
+			Version:        latestVersion,
+			Name:           gf["name"].(string),
+			CacheCondition: gf["cache_condition"].(string),
+		}
+
+		if v, ok := gf["content_types"]; ok {
+			if cs := v.(*schema.Set).List(); len(cs) > 0 {
+				var cl []string
+				for _, c := range cs {
+					cl = append(cl, c.(string))
+				}
+				opts.ContentTypes = strings.Join(cl, " ")
+			}
+		}
+
+		if v, ok := gf["extensions"]; ok {
+			if es := v.(*schema.Set).List(); len(es) > 0 {
+				var el []string
+				for _, e := range es {
+					el = append(el, e.(string))
+				}
+				opts.Extensions = strings.Join(el, " ")
+			}
+		}
+
+		log.Printf("[DEBUG] Fastly Gzip addition opts: %#v", opts)
+
+		if _, err := conn.CreateGzip(&opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readGzip(conn *gofastly.Client, d *schema.ResourceData, s *gofastly.ServiceDetail) error {
+	log.Printf("[DEBUG] Refreshing Gzips for (%s)", d.Id())
+	gzipsList, err := conn.ListGzips(&gofastly.ListGzipsInput{
+		Service: d.Id(),
+		Version: s.ActiveVersion.Number,
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Gzips for (%s), version (%v): %s", d.Id(), s.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("gzip", flattenGzips(gzipsList)); err != nil {
+		log.Printf("[WARN] Error setting Gzips for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenGzips(gzipsList []*gofastly.Gzip) []map[string]interface{} {
+	var gl []map[string]interface{}
+	for _, g := range gzipsList {
+		gl = append(gl, map[string]interface{}{
+			"name":            g.Name,
+			"content_types":   strings.Fields(g.ContentTypes),
+			"extensions":      strings.Fields(g.Extensions),
+			"cache_condition": g.CacheCondition,
+		})
+	}
+
+	return gl
+}
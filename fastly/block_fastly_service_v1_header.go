@@ -0,0 +1,204 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var headerSchema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A unique name to identify this header object",
+			},
+
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The Header manipulation action to take; must be one of `set`, `append`, `delete`, `regex`, or `regex_repeat`",
+				ValidateFunc: validation.StringInSlice([]string{"set", "append", "delete", "regex", "regex_repeat"}, false),
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The Request type on which to apply the selected Action; must be one of `request`, `fetch`, `cache`, or `response`",
+				ValidateFunc: validation.StringInSlice([]string{"request", "fetch", "cache", "response"}, false),
+			},
+
+			"destination": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Header this action will apply to",
+			},
+
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Variable to be used as a source for the header content (required for `set` or `append` actions)",
+			},
+
+			"ignore_if_set": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Don't add the header if it is already present (only applies to `set` action)",
+			},
+
+			"regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Regular expression to use (only applies to `regex` and `regex_repeat` actions)",
+			},
+
+			"substitution": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value to substitute in place of regex (only applies to `regex` and `regex_repeat` actions)",
+			},
+
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "Lower priorities execute first",
+			},
+
+			"request_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a request condition to apply",
+			},
+
+			"cache_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a cache condition to apply",
+			},
+
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a response condition to apply",
+			},
+		},
+	},
+}
+
+func processHeader(d *schema.ResourceData, conn *gofastly.Client, latestVersion int) error {
+	serviceID := d.Id()
+	oh, nh := d.GetChange("header")
+
+	if oh == nil {
+		oh = new(schema.Set)
+	}
+	if nh == nil {
+		nh = new(schema.Set)
+	}
+
+	ohs := oh.(*schema.Set)
+	nhs := nh.(*schema.Set)
+
+	removeHeaders := ohs.Difference(nhs).List()
+	addHeaders := nhs.Difference(ohs).List()
+
+	for _, hRaw := range removeHeaders {
+		hf := hRaw.(map[string]interface{})
+		log.Printf("[DEBUG] Fastly Header removal opts: %#v", hf)
+
+		err := conn.DeleteHeader(&gofastly.DeleteHeaderInput{
+			Service: serviceID,
+			Version: latestVersion,
+			Name:    hf["name"].(string),
+		})
+
+		if errRes, ok := err.(*gofastly.HTTPError); ok {
+			if errRes.StatusCode != 404 {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+	}
+
+	for _, hRaw := range addHeaders {
+		hf := hRaw.(map[string]interface{})
+		opts := buildCreateHeader(hf, serviceID, latestVersion)
+
+		log.Printf("[DEBUG] Fastly Header addition opts: %#v", opts)
+
+		if _, err := conn.CreateHeader(opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readHeader(conn *gofastly.Client, d *schema.ResourceData, s *gofastly.ServiceDetail) error {
+	log.Printf("[DEBUG] Refreshing Headers for (%s)", d.Id())
+	headerList, err := conn.ListHeaders(&gofastly.ListHeadersInput{
+		Service: d.Id(),
+		Version: s.ActiveVersion.Number,
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Headers for (%s), version (%v): %s", d.Id(), s.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("header", flattenHeaders(headerList)); err != nil {
+		log.Printf("[WARN] Error setting Headers for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func buildCreateHeader(headerMap interface{}, serviceID string, serviceVersion int) *gofastly.CreateHeaderInput {
+	hf := headerMap.(map[string]interface{})
+	return &gofastly.CreateHeaderInput{
+		Service:           serviceID,
+		Version:           serviceVersion,
+		Name:              hf["name"].(string),
+		Action:            gofastly.HeaderAction(hf["action"].(string)),
+		Type:              gofastly.HeaderType(hf["type"].(string)),
+		Destination:       hf["destination"].(string),
+		Source:            hf["source"].(string),
+		IgnoreIfSet:       gofastly.CBool(hf["ignore_if_set"].(bool)),
+		Regex:             hf["regex"].(string),
+		Substitution:      hf["substitution"].(string),
+		Priority:          uint(hf["priority"].(int)),
+		RequestCondition:  hf["request_condition"].(string),
+		CacheCondition:    hf["cache_condition"].(string),
+		ResponseCondition: hf["response_condition"].(string),
+	}
+}
+
+func flattenHeaders(headerList []*gofastly.Header) []map[string]interface{} {
+	var hl []map[string]interface{}
+	for _, h := range headerList {
+		hl = append(hl, map[string]interface{}{
+			"name":               h.Name,
+			"action":             h.Action,
+			"ignore_if_set":      h.IgnoreIfSet,
+			"type":               h.Type,
+			"destination":        h.Destination,
+			"source":             h.Source,
+			"regex":              h.Regex,
+			"substitution":       h.Substitution,
+			"priority":           int(h.Priority),
+			"request_condition":  h.RequestCondition,
+			"cache_condition":    h.CacheCondition,
+			"response_condition": h.ResponseCondition,
+		})
+	}
+
+	return hl
+}
@@ -3,6 +3,7 @@ package fastly
 import (
 	"fmt"
 	"log"
+	"reflect"
 
 	gofastly "github.com/fastly/go-fastly/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -154,12 +155,10 @@ func processHTTPS(d *schema.ResourceData, conn *gofastly.Client, latestVersion i
 	ohs := oh.(*schema.Set)
 	nhs := nh.(*schema.Set)
 
-	removeHTTPSLogging := ohs.Difference(nhs).List()
-	addHTTPSLogging := nhs.Difference(ohs).List()
+	removed, added, kept := reconcileHTTPSByName(ohs, nhs)
 
-	// DELETE old HTTPS logging endpoints
-	for _, oRaw := range removeHTTPSLogging {
-		of := oRaw.(map[string]interface{})
+	// DELETE HTTPS logging endpoints that are gone entirely
+	for _, of := range removed {
 		opts := buildDeleteHTTPS(of, serviceID, latestVersion)
 
 		log.Printf("[DEBUG] Fastly HTTPS logging endpoint removal opts: %#v", opts)
@@ -169,10 +168,9 @@ func processHTTPS(d *schema.ResourceData, conn *gofastly.Client, latestVersion i
 		}
 	}
 
-	// POST new/updated HTTPS logging endponts
-	for _, nRaw := range addHTTPSLogging {
-		hf := nRaw.(map[string]interface{})
-		opts := buildCreateHTTPS(hf, serviceID, latestVersion)
+	// POST brand new HTTPS logging endpoints
+	for _, nf := range added {
+		opts := buildCreateHTTPS(nf, serviceID, latestVersion)
 
 		log.Printf("[DEBUG] Fastly HTTPS logging addition opts: %#v", opts)
 
@@ -181,9 +179,66 @@ func processHTTPS(d *schema.ResourceData, conn *gofastly.Client, latestVersion i
 		}
 	}
 
+	// PUT endpoints that persisted across the diff but changed attributes,
+	// updating them in place instead of dropping and recreating them.
+	for _, pair := range kept {
+		if reflect.DeepEqual(pair.old, pair.new) {
+			continue
+		}
+
+		opts := buildUpdateHTTPS(pair.old, pair.new, serviceID, latestVersion)
+
+		log.Printf("[DEBUG] Fastly HTTPS logging endpoint update opts: %#v", opts)
+
+		if _, err := conn.UpdateHTTPS(opts); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// httpsPair holds the before/after attributes of an HTTPS logging endpoint
+// that is present in both the old and new `httpslogging` sets.
+type httpsPair struct {
+	old map[string]interface{}
+	new map[string]interface{}
+}
+
+// reconcileHTTPSByName keys the old and new `httpslogging` sets by `name` so
+// that an attribute change on an existing endpoint can be applied in place
+// rather than as a delete+recreate, which would briefly drop log delivery.
+func reconcileHTTPSByName(ohs, nhs *schema.Set) (removed, added []map[string]interface{}, kept []httpsPair) {
+	oldByName := make(map[string]map[string]interface{}, ohs.Len())
+	for _, r := range ohs.List() {
+		hf := r.(map[string]interface{})
+		oldByName[hf["name"].(string)] = hf
+	}
+
+	newByName := make(map[string]map[string]interface{}, nhs.Len())
+	for _, r := range nhs.List() {
+		hf := r.(map[string]interface{})
+		newByName[hf["name"].(string)] = hf
+	}
+
+	for name, of := range oldByName {
+		nf, ok := newByName[name]
+		if !ok {
+			removed = append(removed, of)
+			continue
+		}
+		kept = append(kept, httpsPair{old: of, new: nf})
+	}
+
+	for name, nf := range newByName {
+		if _, ok := oldByName[name]; !ok {
+			added = append(added, nf)
+		}
+	}
+
+	return removed, added, kept
+}
+
 func readHTTPS(conn *gofastly.Client, d *schema.ResourceData, s *gofastly.ServiceDetail) error {
 	// refresh HTTPS
 	log.Printf("[DEBUG] Refreshing HTTPS logging endpoints for (%s)", d.Id())
@@ -304,3 +359,68 @@ func buildDeleteHTTPS(httpsMap interface{}, serviceID string, serviceVersion int
 
 	return &opts
 }
+
+// buildUpdateHTTPS diffs an HTTPS logging endpoint's old and new attributes
+// and sets only the fields that actually changed, so the PUT only touches
+// what's different instead of resending the whole endpoint.
+func buildUpdateHTTPS(of, nf map[string]interface{}, serviceID string, serviceVersion int) *gofastly.UpdateHTTPSInput {
+	opts := gofastly.UpdateHTTPSInput{
+		Service: serviceID,
+		Version: serviceVersion,
+		Name:    of["name"].(string),
+	}
+
+	if v := nf["url"].(string); v != of["url"].(string) {
+		opts.URL = gofastly.String(v)
+	}
+	if v := uint(nf["request_max_entries"].(int)); v != uint(of["request_max_entries"].(int)) {
+		opts.RequestMaxEntries = gofastly.Uint(v)
+	}
+	if v := uint(nf["request_max_bytes"].(int)); v != uint(of["request_max_bytes"].(int)) {
+		opts.RequestMaxBytes = gofastly.Uint(v)
+	}
+	if v := nf["content_type"].(string); v != of["content_type"].(string) {
+		opts.ContentType = gofastly.String(v)
+	}
+	if v := nf["header_name"].(string); v != of["header_name"].(string) {
+		opts.HeaderName = gofastly.String(v)
+	}
+	if v := nf["header_value"].(string); v != of["header_value"].(string) {
+		opts.HeaderValue = gofastly.String(v)
+	}
+	if v := nf["method"].(string); v != of["method"].(string) {
+		opts.Method = gofastly.String(v)
+	}
+	if v := nf["json_format"].(string); v != of["json_format"].(string) {
+		opts.JSONFormat = gofastly.String(v)
+	}
+	if v := nf["tls_ca_cert"].(string); v != of["tls_ca_cert"].(string) {
+		opts.TLSCACert = gofastly.String(v)
+	}
+	if v := nf["tls_client_cert"].(string); v != of["tls_client_cert"].(string) {
+		opts.TLSClientCert = gofastly.String(v)
+	}
+	if v := nf["tls_client_key"].(string); v != of["tls_client_key"].(string) {
+		opts.TLSClientKey = gofastly.String(v)
+	}
+	if v := nf["tls_hostname"].(string); v != of["tls_hostname"].(string) {
+		opts.TLSHostname = gofastly.String(v)
+	}
+	if v := nf["format"].(string); v != of["format"].(string) {
+		opts.Format = gofastly.String(v)
+	}
+	if v := uint(nf["format_version"].(int)); v != uint(of["format_version"].(int)) {
+		opts.FormatVersion = gofastly.Uint(v)
+	}
+	if v := nf["message_type"].(string); v != of["message_type"].(string) {
+		opts.MessageType = gofastly.String(v)
+	}
+	if v := nf["placement"].(string); v != of["placement"].(string) {
+		opts.Placement = gofastly.String(v)
+	}
+	if v := nf["response_condition"].(string); v != of["response_condition"].(string) {
+		opts.ResponseCondition = gofastly.String(v)
+	}
+
+	return &opts
+}
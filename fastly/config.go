@@ -0,0 +1,37 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+)
+
+// FastlyClient wraps the underlying go-fastly API client so it can be
+// threaded through resource CRUD functions via the provider's meta value.
+type FastlyClient struct {
+	conn *gofastly.Client
+}
+
+// Config holds the provider-level configuration needed to build a
+// FastlyClient.
+type Config struct {
+	ApiKey  string
+	BaseURL string
+}
+
+// Client returns a new FastlyClient configured from c.
+func (c *Config) Client() (*FastlyClient, error) {
+	client, err := gofastly.NewClient(c.ApiKey)
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error initializing Fastly client: %s", err)
+	}
+
+	if c.BaseURL != "" {
+		client.BaseURL = c.BaseURL
+	}
+
+	log.Printf("[INFO] Fastly Client configured")
+
+	return &FastlyClient{conn: client}, nil
+}
@@ -0,0 +1,42 @@
+package fastly
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// Provider returns a terraform.ResourceProvider for Fastly.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_API_KEY", nil),
+				Description: "The Fastly API key used to authenticate requests to the Fastly API.",
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_API_URL", ""),
+				Description: "Fastly API URL",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"fastly_purge":      resourcePurge(),
+			"fastly_service_v1": resourceServiceV1(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := Config{
+		ApiKey:  d.Get("api_key").(string),
+		BaseURL: d.Get("base_url").(string),
+	}
+
+	return config.Client()
+}
@@ -0,0 +1,25 @@
+package fastly
+
+// purgeKeysMaxBatch is the maximum number of surrogate keys Fastly accepts
+// in a single Surrogate-Key header. Mirrors the same constant in the
+// vendored go-fastly client.
+const purgeKeysMaxBatch = 256
+
+// batchPurgeKeys splits keys into chunks of at most purgeKeysMaxBatch, in
+// order, for go-fastly's PurgeKeys to send one Surrogate-Key header per
+// chunk. It's duplicated from the go-fastly client's own batching loop
+// (vendor/github.com/fastly/go-fastly/fastly/purge.go) so the behavior can
+// be covered by a test that actually runs: that package is vendored, and
+// `go test ./...` skips everything under vendor/.
+func batchPurgeKeys(keys []string) [][]string {
+	var batches [][]string
+	for start := 0; start < len(keys); start += purgeKeysMaxBatch {
+		end := start + purgeKeysMaxBatch
+		if end > len(keys) {
+			end = len(keys)
+		}
+		batches = append(batches, keys[start:end])
+	}
+
+	return batches
+}
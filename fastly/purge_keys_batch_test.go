@@ -0,0 +1,43 @@
+package fastly
+
+import "testing"
+
+func TestBatchPurgeKeys(t *testing.T) {
+	keys := func(n int) []string {
+		k := make([]string, n)
+		for i := range k {
+			k[i] = string(rune('a' + i%26))
+		}
+		return k
+	}
+
+	cases := []struct {
+		name    string
+		keys    []string
+		batches []int // expected length of each batch
+	}{
+		{"empty", keys(0), nil},
+		{"exactly one batch", keys(256), []int{256}},
+		{"one over a batch", keys(257), []int{256, 1}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := batchPurgeKeys(c.keys)
+			if len(got) != len(c.batches) {
+				t.Fatalf("batchPurgeKeys(%d keys) returned %d batches, want %d", len(c.keys), len(got), len(c.batches))
+			}
+
+			var total int
+			for i, b := range got {
+				if len(b) != c.batches[i] {
+					t.Errorf("batch %d has %d keys, want %d", i, len(b), c.batches[i])
+				}
+				total += len(b)
+			}
+			if total != len(c.keys) {
+				t.Errorf("batches contain %d keys total, want %d", total, len(c.keys))
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourcePurge() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePurgeCreate,
+		Read:   resourcePurgeRead,
+		Delete: resourcePurgeDelete,
+
+		CustomizeDiff: resourcePurgeCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"service_id", "key", "keys"},
+				Description:   "The URL to purge. Conflicts with `service_id`.",
+			},
+
+			"service_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"url"},
+				Description:   "The service to purge. Used alone this purges the entire service; combined with `key` it purges a single surrogate key.",
+			},
+
+			"key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"url", "keys"},
+				Description:   "The surrogate key to purge. Requires `service_id`. Conflicts with `keys`.",
+			},
+
+			"keys": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"url", "key"},
+				Description:   "A list of surrogate keys to purge atomically. Requires `service_id`. Conflicts with `key`.",
+			},
+
+			"soft": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to issue a soft purge, which marks content stale rather than instantly evicting it.",
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A map of arbitrary strings that, when changed, causes the purge to be re-run. See the docs on `null_resource`.",
+			},
+
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status returned by the Fastly API for this purge.",
+			},
+
+			"purge_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of surrogate key to the purge ID returned for it. Only populated when `keys` is used.",
+			},
+		},
+	}
+}
+
+// resourcePurgeCustomizeDiff enforces the `key`/`keys` -> `service_id`
+// dependency that ConflictsWith alone can't express, so an invalid
+// combination fails at plan time instead of silently purging the wrong
+// thing in Create.
+func resourcePurgeCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	key := d.Get("key").(string)
+	keys := d.Get("keys").([]interface{})
+
+	if (key != "" || len(keys) > 0) && d.Get("service_id").(string) == "" {
+		return fmt.Errorf("[ERR] `service_id` is required when `key` or `keys` is set")
+	}
+
+	return nil
+}
+
+func resourcePurgeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	url := d.Get("url").(string)
+	serviceID := d.Get("service_id").(string)
+	key := d.Get("key").(string)
+	soft := d.Get("soft").(bool)
+
+	var keys []string
+	for _, k := range d.Get("keys").([]interface{}) {
+		keys = append(keys, k.(string))
+	}
+
+	switch {
+	case url != "":
+		log.Printf("[DEBUG] Purging URL %s (soft: %v)", url, soft)
+		purge, err := conn.Purge(&gofastly.PurgeInput{
+			URL:  url,
+			Soft: soft,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error purging: %s", err)
+		}
+
+		d.SetId(purge.ID)
+		if err := d.Set("status", purge.Status); err != nil {
+			log.Printf("[WARN] Error setting status for purge (%s): %s", d.Id(), err)
+		}
+	case serviceID != "" && len(keys) > 0:
+		log.Printf("[DEBUG] Purging keys %v for service %s (soft: %v)", keys, serviceID, soft)
+		purgeIDs, err := conn.PurgeKeys(&gofastly.PurgeKeysInput{
+			Service: serviceID,
+			Keys:    keys,
+			Soft:    soft,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error purging: %s", err)
+		}
+
+		d.SetId(resource.UniqueId())
+		if err := d.Set("purge_ids", purgeIDs); err != nil {
+			log.Printf("[WARN] Error setting purge_ids for purge (%s): %s", d.Id(), err)
+		}
+	case serviceID != "" && key != "":
+		log.Printf("[DEBUG] Purging key %s for service %s (soft: %v)", key, serviceID, soft)
+		purge, err := conn.PurgeKey(&gofastly.PurgeKeyInput{
+			Service: serviceID,
+			Key:     key,
+			Soft:    soft,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error purging: %s", err)
+		}
+
+		d.SetId(purge.ID)
+		if err := d.Set("status", purge.Status); err != nil {
+			log.Printf("[WARN] Error setting status for purge (%s): %s", d.Id(), err)
+		}
+	case serviceID != "":
+		log.Printf("[DEBUG] Purging all of service %s (soft: %v)", serviceID, soft)
+		purge, err := conn.PurgeAll(&gofastly.PurgeAllInput{
+			Service: serviceID,
+			Soft:    soft,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error purging: %s", err)
+		}
+
+		d.SetId(purge.ID)
+		if err := d.Set("status", purge.Status); err != nil {
+			log.Printf("[WARN] Error setting status for purge (%s): %s", d.Id(), err)
+		}
+	default:
+		return fmt.Errorf("[ERR] Error creating purge: one of `url`, `service_id`+`keys`, `service_id`+`key`, or `service_id` must be set")
+	}
+
+	return nil
+}
+
+func resourcePurgeRead(d *schema.ResourceData, meta interface{}) error {
+	// Purges are fire-and-forget: there is no API to look up a past purge by
+	// ID, so the state recorded at create time is authoritative.
+	return nil
+}
+
+func resourcePurgeDelete(d *schema.ResourceData, meta interface{}) error {
+	// Purging cannot be undone; deleting the resource only removes it from
+	// state.
+	d.SetId("")
+	return nil
+}
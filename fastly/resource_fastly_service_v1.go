@@ -0,0 +1,316 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceServiceV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceV1Create,
+		Read:   resourceServiceV1Read,
+		Update: resourceServiceV1Update,
+		Delete: resourceServiceV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name for this Service",
+			},
+
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Managed by Terraform",
+				Description: "Description field for the service",
+			},
+
+			"active_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"activate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to activate newly created draft versions. Default true",
+			},
+
+			"domain":        domainSchema,
+			"backend":       backendSchema,
+			"condition":     conditionSchema,
+			"header":        headerSchema,
+			"gzip":          gzipSchema,
+			"cache_setting": cacheSettingSchema,
+			"httpslogging":  httpsloggingSchema,
+		},
+	}
+}
+
+func resourceServiceV1Create(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	service, err := conn.CreateService(&gofastly.CreateServiceInput{
+		Name:    d.Get("name").(string),
+		Comment: d.Get("comment").(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(service.ID)
+	return resourceServiceV1Update(d, meta)
+}
+
+// resourceServiceV1Update clones the service's currently active version (or
+// reuses the as-yet-unactivated version 1 for a brand new service), applies
+// every block's diff to the clone, validates it, and activates it unless
+// `activate` is false.
+func resourceServiceV1Update(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+	serviceID := d.Id()
+
+	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{ID: serviceID})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error retrieving details for service (%s): %s", serviceID, err)
+	}
+
+	latestVersion := s.ActiveVersion.Number
+	if latestVersion == 0 {
+		// No active version yet: this is a brand new service, so work
+		// directly on the draft version 1 created with it.
+		latestVersion = 1
+	} else {
+		log.Printf("[DEBUG] Cloning Fastly Service (%s), version (%v)", serviceID, latestVersion)
+		newVersion, err := conn.CloneVersion(&gofastly.CloneVersionInput{
+			Service: serviceID,
+			Version: latestVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error cloning version (%v) for service (%s): %s", latestVersion, serviceID, err)
+		}
+		latestVersion = newVersion.Number
+	}
+
+	if d.HasChange("name") || d.HasChange("comment") {
+		if _, err := conn.UpdateService(&gofastly.UpdateServiceInput{
+			ID:      serviceID,
+			Name:    d.Get("name").(string),
+			Comment: d.Get("comment").(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Each block is applied against the cloned version independently, so
+	// mark state partial until every block has succeeded: if one fails
+	// partway through, Terraform must only persist the blocks that were
+	// actually written to this version, not the full (still-draft) config,
+	// or a later apply would see no diff and never retry the failed ones.
+	d.Partial(true)
+
+	// Conditions are created before, and deleted after, every other block:
+	// backend/header/gzip/cache_setting/httpslogging entries can
+	// reference a condition by name, so a new condition must exist before
+	// anything that references it is written, and a removed condition must
+	// not be deleted while something still references it.
+	if d.HasChange("condition") {
+		if err := processConditionCreate(d, conn, latestVersion); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("domain") {
+		if err := processDomain(d, conn, latestVersion); err != nil {
+			return err
+		}
+		d.SetPartial("domain")
+	}
+
+	if d.HasChange("backend") {
+		if err := processBackend(d, conn, latestVersion); err != nil {
+			return err
+		}
+		d.SetPartial("backend")
+	}
+
+	if d.HasChange("header") {
+		if err := processHeader(d, conn, latestVersion); err != nil {
+			return err
+		}
+		d.SetPartial("header")
+	}
+
+	if d.HasChange("gzip") {
+		if err := processGzip(d, conn, latestVersion); err != nil {
+			return err
+		}
+		d.SetPartial("gzip")
+	}
+
+	if d.HasChange("cache_setting") {
+		if err := processCacheSetting(d, conn, latestVersion); err != nil {
+			return err
+		}
+		d.SetPartial("cache_setting")
+	}
+
+	if d.HasChange("httpslogging") {
+		if err := processHTTPS(d, conn, latestVersion); err != nil {
+			return err
+		}
+		d.SetPartial("httpslogging")
+	}
+
+	if d.HasChange("condition") {
+		if err := processConditionDelete(d, conn, latestVersion); err != nil {
+			return err
+		}
+		d.SetPartial("condition")
+	}
+
+	d.Partial(false)
+
+	valid, msg, err := conn.ValidateVersion(&gofastly.ValidateVersionInput{
+		Service: serviceID,
+		Version: latestVersion,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error validating version (%v) for service (%s): %s", latestVersion, serviceID, err)
+	}
+	if !valid {
+		return fmt.Errorf("[ERR] Invalid configuration for version (%v) of service (%s): %s", latestVersion, serviceID, msg)
+	}
+
+	if d.Get("activate").(bool) {
+		log.Printf("[DEBUG] Activating Fastly Service (%s), version (%v)", serviceID, latestVersion)
+		if _, err := conn.ActivateVersion(&gofastly.ActivateVersionInput{
+			Service: serviceID,
+			Version: latestVersion,
+		}); err != nil {
+			return fmt.Errorf("[ERR] Error activating version (%v) for service (%s): %s", latestVersion, serviceID, err)
+		}
+
+		return resourceServiceV1Read(d, meta)
+	}
+
+	// `activate` is false, so the service's active version hasn't changed
+	// and resourceServiceV1Read would read the blocks back from it rather
+	// than from the draft just written to latestVersion. Read the blocks
+	// back from latestVersion instead, so the state saved by this apply
+	// matches the draft. A later refresh goes through resourceServiceV1Read
+	// and reads the active version again.
+	return resourceServiceV1ReadVersion(d, meta, latestVersion)
+}
+
+func resourceServiceV1Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+	serviceID := d.Id()
+
+	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{ID: serviceID})
+	if err != nil {
+		if errRes, ok := err.(*gofastly.HTTPError); ok && errRes.StatusCode == 404 {
+			log.Printf("[WARN] Fastly Service (%s) not found, removing from state", serviceID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", s.Name)
+	d.Set("comment", s.Comment)
+	d.Set("active_version", s.ActiveVersion.Number)
+
+	if s.ActiveVersion.Number == 0 {
+		// Service has never been activated: nothing to read back yet.
+		return nil
+	}
+
+	return readServiceV1Blocks(conn, d, s)
+}
+
+// resourceServiceV1ReadVersion refreshes name/comment/active_version as
+// normal, but reads the block state back from a specific version rather
+// than whatever is currently active. It's used after Update when
+// `activate` is false, since in that case the version just written
+// (version) is a draft that hasn't become the service's active version.
+func resourceServiceV1ReadVersion(d *schema.ResourceData, meta interface{}, version int) error {
+	conn := meta.(*FastlyClient).conn
+	serviceID := d.Id()
+
+	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{ID: serviceID})
+	if err != nil {
+		if errRes, ok := err.(*gofastly.HTTPError); ok && errRes.StatusCode == 404 {
+			log.Printf("[WARN] Fastly Service (%s) not found, removing from state", serviceID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", s.Name)
+	d.Set("comment", s.Comment)
+	d.Set("active_version", s.ActiveVersion.Number)
+
+	s.ActiveVersion.Number = version
+	return readServiceV1Blocks(conn, d, s)
+}
+
+// readServiceV1Blocks refreshes every block attribute from the version
+// recorded on s.ActiveVersion.Number.
+func readServiceV1Blocks(conn *gofastly.Client, d *schema.ResourceData, s *gofastly.ServiceDetail) error {
+	if err := readDomain(conn, d, s); err != nil {
+		return err
+	}
+	if err := readCondition(conn, d, s); err != nil {
+		return err
+	}
+	if err := readBackend(conn, d, s); err != nil {
+		return err
+	}
+	if err := readHeader(conn, d, s); err != nil {
+		return err
+	}
+	if err := readGzip(conn, d, s); err != nil {
+		return err
+	}
+	if err := readCacheSetting(conn, d, s); err != nil {
+		return err
+	}
+	if err := readHTTPS(conn, d, s); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceServiceV1Delete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+	serviceID := d.Id()
+
+	if d.Get("active_version").(int) != 0 {
+		log.Printf("[DEBUG] Deactivating Fastly Service (%s), version (%v)", serviceID, d.Get("active_version").(int))
+		if _, err := conn.DeactivateVersion(&gofastly.DeactivateVersionInput{
+			Service: serviceID,
+			Version: d.Get("active_version").(int),
+		}); err != nil {
+			return fmt.Errorf("[ERR] Error deactivating service (%s): %s", serviceID, err)
+		}
+	}
+
+	if err := conn.DeleteService(&gofastly.DeleteServiceInput{ID: serviceID}); err != nil {
+		return fmt.Errorf("[ERR] Error deleting service (%s): %s", serviceID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
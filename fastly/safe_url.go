@@ -0,0 +1,21 @@
+package fastly
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ToSafeURL joins segments into a `/`-prefixed path, percent-escaping each
+// segment individually so that a service ID, key, or endpoint name
+// containing `/`, whitespace, or non-ASCII characters cannot be
+// misinterpreted as additional path segments or otherwise break routing.
+// Any provider code that builds a raw API path (rather than going through
+// a structured go-fastly input struct) should route it through this.
+func ToSafeURL(segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, s := range segments {
+		escaped[i] = url.PathEscape(s)
+	}
+
+	return "/" + strings.Join(escaped, "/")
+}
@@ -0,0 +1,24 @@
+package fastly
+
+import "testing"
+
+func TestToSafeURL(t *testing.T) {
+	cases := []struct {
+		segments []string
+		want     string
+	}{
+		{[]string{"service", "abc123", "purge_all"}, "/service/abc123/purge_all"},
+		{[]string{"service", "abc/123", "purge", "my-key"}, "/service/abc%2F123/purge/my-key"},
+		{[]string{"service", "abc123", "purge", "has?query"}, "/service/abc123/purge/has%3Fquery"},
+		{[]string{"service", "abc123", "purge", "has#fragment"}, "/service/abc123/purge/has%23fragment"},
+		{[]string{"service", "abc123", "purge", "has space"}, "/service/abc123/purge/has%20space"},
+		{[]string{"service", "abc123", "purge", "Über"}, "/service/abc123/purge/%C3%9Cber"},
+	}
+
+	for _, c := range cases {
+		got := ToSafeURL(c.segments...)
+		if got != c.want {
+			t.Errorf("ToSafeURL(%#v) = %q, want %q", c.segments, got, c.want)
+		}
+	}
+}
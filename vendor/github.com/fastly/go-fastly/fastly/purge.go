@@ -1,6 +1,6 @@
 package fastly
 
-import "fmt"
+import "strings"
 
 // Purge is a response from a purge request.
 type Purge struct {
@@ -66,7 +66,7 @@ func (c *Client) PurgeKey(i *PurgeKeyInput) (*Purge, error) {
 		return nil, ErrMissingKey
 	}
 
-	path := fmt.Sprintf("/service/%s/purge/%s", i.Service, i.Key)
+	path := toSafeURL("service", i.Service, "purge", i.Key)
 
 	ro := new(RequestOptions)
 	ro.Parallel = true
@@ -106,7 +106,7 @@ func (c *Client) PurgeAll(i *PurgeAllInput) (*Purge, error) {
 		return nil, ErrMissingService
 	}
 
-	path := fmt.Sprintf("/service/%s/purge_all", i.Service)
+	path := toSafeURL("service", i.Service, "purge_all")
 	req, err := c.RawRequest("POST", path, nil)
 	if err != nil {
 		return nil, err
@@ -128,3 +128,84 @@ func (c *Client) PurgeAll(i *PurgeAllInput) (*Purge, error) {
 	return r, nil
 
 }
+
+// purgeKeysMaxBatch is the maximum number of surrogate keys Fastly accepts
+// in a single Surrogate-Key header.
+const purgeKeysMaxBatch = 256
+
+// batchPurgeKeys splits keys into chunks of at most purgeKeysMaxBatch, in
+// order, so PurgeKeys can send one Surrogate-Key header per chunk.
+//
+// This mirrors the terraform-provider-fastly-side fastly.batchPurgeKeys
+// helper, where it has test coverage; it's duplicated rather than imported
+// because this package is vendored and can't depend on the module that
+// vendors it.
+func batchPurgeKeys(keys []string) [][]string {
+	var batches [][]string
+	for start := 0; start < len(keys); start += purgeKeysMaxBatch {
+		end := start + purgeKeysMaxBatch
+		if end > len(keys) {
+			end = len(keys)
+		}
+		batches = append(batches, keys[start:end])
+	}
+
+	return batches
+}
+
+// PurgeKeysInput is used as input to the PurgeKeys function.
+type PurgeKeysInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Keys is the list of surrogate keys to purge (required).
+	Keys []string
+
+	// Soft performs a soft purge.
+	Soft bool
+}
+
+// PurgeKeys instantly purges a particular service of items tagged with any
+// of the given surrogate keys, batching the keys in groups of at most
+// purgeKeysMaxBatch per request, and returns a purge ID per key.
+func (c *Client) PurgeKeys(i *PurgeKeysInput) (map[string]string, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if len(i.Keys) == 0 {
+		return nil, ErrMissingKey
+	}
+
+	path := toSafeURL("service", i.Service, "purge")
+
+	purgeIDs := make(map[string]string, len(i.Keys))
+	for _, batch := range batchPurgeKeys(i.Keys) {
+		ro := new(RequestOptions)
+		ro.Parallel = true
+		req, err := c.RawRequest("POST", path, ro)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Surrogate-Key", strings.Join(batch, " "))
+		if i.Soft {
+			req.Header.Set("Fastly-Soft-Purge", "1")
+		}
+
+		resp, err := checkResp(c.HTTPClient.Do(req))
+		if err != nil {
+			return nil, err
+		}
+
+		var r map[string]string
+		if err := decodeJSON(&r, resp.Body); err != nil {
+			return nil, err
+		}
+		for key, id := range r {
+			purgeIDs[key] = id
+		}
+	}
+
+	return purgeIDs, nil
+}
@@ -0,0 +1,24 @@
+package fastly
+
+import (
+	"net/url"
+	"strings"
+)
+
+// toSafeURL joins segments into a `/`-prefixed path, percent-escaping each
+// segment individually so that a service ID, key, or endpoint name
+// containing `/`, whitespace, or non-ASCII characters cannot be
+// misinterpreted as additional path segments or otherwise break routing.
+//
+// This mirrors the terraform-provider-fastly-side fastly.ToSafeURL helper;
+// it's duplicated rather than imported because this package is vendored
+// and can't depend on the module that vendors it. The canonical, tested
+// copy lives in that module's own fastly package.
+func toSafeURL(segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, s := range segments {
+		escaped[i] = url.PathEscape(s)
+	}
+
+	return "/" + strings.Join(escaped, "/")
+}